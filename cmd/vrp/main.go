@@ -0,0 +1,107 @@
+// Command vrp solves vehicle routing problems read from a simple
+// space-delimited text format and prints the resulting routes.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/andy-trimble/vrp/pkg/vrp"
+)
+
+func main() {
+	format := flag.String("format", "text", "output format: text|dot")
+	improveLevel := flag.String("improve", "none", "local-search level: none|intra|full")
+	profilePath := flag.String("profile", "", "path to a VehicleProfile JSON file")
+	variant := flag.String("vrp-variant", "", "constraint variant: cvrp|vrptw|cvrptw")
+	algorithm := flag.String("algorithm", "clarke-wright", "construction heuristic: clarke-wright|sweep|nearest-neighbor")
+	benchmark := flag.Bool("benchmark", false, "compare all construction heuristics instead of solving")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatalf("usage: vrp [--algorithm=clarke-wright|sweep|nearest-neighbor] [--format=text|dot] [--improve=none|intra|full] [--profile=file] [--vrp-variant=cvrp|vrptw|cvrptw] [--benchmark] [file input]")
+	}
+
+	fileName := flag.Arg(0)
+
+	deliveries, err := vrp.Parse(fileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var profile vrp.VehicleProfile
+	if *profilePath != "" {
+		profile, err = vrp.LoadProfile(*profilePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	problem := vrp.Problem{Deliveries: deliveries, Profile: profile, Variant: *variant}
+
+	if *benchmark {
+		runBenchmark(problem)
+		return
+	}
+
+	solver, err := newSolver(*algorithm)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sol, err := solver.Solve(problem)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	drivers, err := vrp.Improve(sol.Drivers, *improveLevel, profile, *variant)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	enc, err := vrp.NewEncoder(*format, drivers)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := enc.Encode(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// newSolver returns the Solver for the requested --algorithm value.
+func newSolver(algorithm string) (vrp.Solver, error) {
+	switch algorithm {
+	case "", "clarke-wright":
+		return vrp.ClarkeWrightSolver{}, nil
+	case "sweep":
+		return vrp.SweepSolver{}, nil
+	case "nearest-neighbor":
+		return vrp.NearestNeighborSolver{}, nil
+	default:
+		return nil, fmt.Errorf("unknown algorithm %q", algorithm)
+	}
+}
+
+// runBenchmark runs every construction heuristic over problem and prints
+// a table comparing their route counts, total drive time, total cost
+// (drive time plus the profile's FixedCost per route), and wall-clock
+// duration.
+func runBenchmark(problem vrp.Problem) {
+	results := vrp.Benchmark(problem,
+		vrp.NamedSolver{Name: "clarke-wright", Solver: vrp.ClarkeWrightSolver{}},
+		vrp.NamedSolver{Name: "sweep", Solver: vrp.SweepSolver{}},
+		vrp.NamedSolver{Name: "nearest-neighbor", Solver: vrp.NearestNeighborSolver{}},
+	)
+
+	fmt.Printf("%-18s %10s %14s %14s %12s\n", "algorithm", "routes", "total_time", "total_cost", "elapsed")
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("%-18s error: %v\n", r.Name, r.Err)
+			continue
+		}
+		fmt.Printf("%-18s %10d %14.2f %14.2f %12s\n", r.Name, r.RouteCount, r.TotalTime, r.TotalCost, r.Elapsed)
+	}
+}
@@ -0,0 +1,63 @@
+package vrp
+
+import "time"
+
+// NamedSolver pairs a Solver with the label to report it under in
+// Benchmark results.
+type NamedSolver struct {
+	Name   string
+	Solver Solver
+}
+
+// BenchmarkResult summarizes a single Solver's performance on a Problem,
+// as reported by Benchmark.
+type BenchmarkResult struct {
+	Name       string
+	RouteCount int
+	TotalTime  float64
+	TotalCost  float64
+	Elapsed    time.Duration
+	Err        error
+}
+
+// Benchmark runs each of solvers over its own copy of problem and
+// reports, for each, the resulting route count, total drive time, total
+// cost (drive time plus the profile's FixedCost per route), and
+// wall-clock duration, so users can compare heuristics on their own
+// inputs.
+func Benchmark(problem Problem, solvers ...NamedSolver) []BenchmarkResult {
+	results := make([]BenchmarkResult, 0, len(solvers))
+
+	for _, ns := range solvers {
+		start := time.Now()
+		sol, err := ns.Solver.Solve(cloneProblem(problem))
+		elapsed := time.Since(start)
+
+		result := BenchmarkResult{Name: ns.Name, Elapsed: elapsed, Err: err}
+		if err == nil {
+			result.RouteCount = len(sol.Drivers)
+			for _, d := range sol.Drivers {
+				result.TotalTime += computeTime(d.Route)
+			}
+			result.TotalCost = result.TotalTime + problem.Profile.FixedCost*float64(result.RouteCount)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// cloneProblem returns a copy of p with its own unshared Delivery
+// pointers, so each Solver run by Benchmark starts from a clean,
+// unassigned Problem regardless of what an earlier run did to p.
+func cloneProblem(p Problem) Problem {
+	deliveries := make(map[int]*Delivery, len(p.Deliveries))
+	for id, d := range p.Deliveries {
+		cp := *d
+		cp.Assigned = nil
+		deliveries[id] = &cp
+	}
+
+	return Problem{Deliveries: deliveries, Profile: p.Profile, Variant: p.Variant}
+}
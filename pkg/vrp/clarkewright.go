@@ -0,0 +1,150 @@
+package vrp
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ClarkeWrightSolver builds routes with the Clarke-Wright savings
+// algorithm: starting from one driver per delivery, it greedily merges
+// the pair of routes with the largest savings first, subject to the
+// Problem's VehicleProfile and variant constraints.
+// https://web.mit.edu/urban_or_book/www/book/chapter6/6.4.12.html
+type ClarkeWrightSolver struct{}
+
+func (ClarkeWrightSolver) Solve(p Problem) (Solution, error) {
+	return Solution{Drivers: solve(p.Deliveries, p.Profile, p.Variant)}, nil
+}
+
+func solve(routes map[int]*Delivery, profile VehicleProfile, variant string) []*Driver {
+	s := savings(routes)
+
+	drivers := make([]*Driver, 0)
+
+	for _, link := range s {
+		load1 := routes[link.SourceID]
+		load2 := routes[link.DestinationID]
+
+		switch {
+
+		// Neither load is assigned
+		case load1.Assigned == nil && load2.Assigned == nil:
+			arr := make([]*Delivery, 2)
+			arr[0] = load1
+			arr[1] = load2
+
+			if feasible(arr, profile, variant) {
+				driver := Driver{
+					ID:    uuid.Must(uuid.NewV7()).String(),
+					Route: make([]*Delivery, 0),
+				}
+				driver.Route = append(driver.Route, load1)
+				driver.Route = append(driver.Route, load2)
+				drivers = append(drivers, &driver)
+				load1.Assigned = &driver
+				load2.Assigned = &driver
+
+			}
+
+		// Load 1 is assigned, but load 2 is not
+		case load1.Assigned != nil && load2.Assigned == nil:
+			driver := load1.Assigned
+			i := indexOf(load1, driver.Route)
+
+			// if node is the last node of route
+			if i == len(driver.Route)-1 {
+				// check constraints
+				arr := make([]*Delivery, 0)
+				arr = append(arr, driver.Route...)
+				arr = append(arr, load2)
+				if feasible(arr, profile, variant) {
+					driver.Route = append(driver.Route, load2)
+					load2.Assigned = driver
+				}
+			}
+
+		// Load 2 is assigned, but load 1 is not
+		case load1.Assigned == nil && load2.Assigned != nil:
+			driver := load2.Assigned
+			i := indexOf(load2, driver.Route)
+			// if node is the first node of route
+			if i == 0 {
+				// check constraints
+				arr := make([]*Delivery, 0)
+				arr = append(arr, driver.Route...)
+				arr = append(arr, load1)
+				if feasible(arr, profile, variant) {
+					driver.Route = append(driver.Route, load1)
+					load1.Assigned = driver
+				}
+			}
+
+		// Both loads are already assigned
+		default:
+			driver1 := load1.Assigned
+			i1 := indexOf(load1, driver1.Route)
+
+			driver2 := load2.Assigned
+			i2 := indexOf(load2, driver2.Route)
+
+			// if node1 is the last node of its route and node 2 is the first node of its route and the routes are different
+			if (i1 == len(driver1.Route)-1) && (i2 == 0) && (driver1.ID != driver2.ID) {
+				arr := make([]*Delivery, 0)
+				arr = append(arr, driver1.Route...)
+				arr = append(arr, driver2.Route...)
+				if feasible(arr, profile, variant) {
+					driver1.Route = append(driver1.Route, driver2.Route...)
+					for _, load := range driver2.Route {
+						load.Assigned = driver1
+					}
+					drivers = removeDriver(drivers, *driver2)
+				}
+			}
+		}
+	}
+
+	// Assign all unassigned routes to individual drivers
+	for _, load := range routes {
+		if load.Assigned == nil {
+			driver := Driver{
+				ID:    uuid.Must(uuid.NewV7()).String(),
+				Route: make([]*Delivery, 0),
+			}
+			driver.Route = append(driver.Route, load)
+			drivers = append(drivers, &driver)
+			load.Assigned = &driver
+		}
+	}
+
+	return drivers
+}
+
+// savings computes the Clarke-Wright savings for every ordered pair of
+// deliveries, sorted in descending order.
+func savings(routes map[int]*Delivery) []Savings {
+	savings := make([]Savings, 0)
+
+	for _, i := range routes {
+		for _, j := range routes {
+			if i == j {
+				continue
+			}
+
+			// Formula: savings = D(i.dropoff, 0) + D(0, j.pickup) - D(i.dropoff, j.pickup)
+			saving := distance(i.Destination, Depot) + distance(Depot, j.Source) - distance(i.Destination, j.Source)
+			savings = append(savings, Savings{
+				SourceID:      i.ID,
+				DestinationID: j.ID,
+				Amount:        saving,
+			})
+		}
+	}
+
+	// Sort in descending order
+	sort.Slice(savings, func(i, j int) bool {
+		return savings[i].Amount > savings[j].Amount
+	})
+
+	return savings
+}
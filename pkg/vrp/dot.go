@@ -0,0 +1,114 @@
+package vrp
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// depotNodeID is the reserved graph.Node ID used for the depot. Delivery
+// IDs are offset by one when used as node IDs so they never collide with it.
+const depotNodeID int64 = 0
+
+// routeColors is cycled through to give each driver's chain of edges a
+// distinct color when rendered by Graphviz.
+var routeColors = []string{"red", "blue", "forestgreen", "darkorange", "purple", "brown", "deeppink", "teal"}
+
+// DotEncoder renders a solved set of driver routes as a directed graph in
+// Graphviz DOT format, suitable for piping into `dot -Tpng` or similar.
+// The depot is a single distinguished node, every Delivery is a node
+// labeled with its ID and (x,y) coordinates, and each driver's route is
+// drawn as a colored chain of edges weighted by the Euclidean distance of
+// that leg (including the depot<->route legs).
+type DotEncoder struct {
+	Drivers []*Driver
+}
+
+func (e DotEncoder) Encode(w io.Writer) error {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+
+	depot := dotNode{id: depotNodeID, label: "depot"}
+	g.AddNode(depot)
+
+	for i, d := range e.Drivers {
+		color := routeColors[i%len(routeColors)]
+
+		nodes := make([]dotNode, len(d.Route))
+		for j, del := range d.Route {
+			nodes[j] = deliveryNode(del)
+			g.AddNode(nodes[j])
+		}
+
+		prev := graph.Node(depot)
+		prevPoint := Depot
+		for j, n := range nodes {
+			g.SetWeightedEdge(dotEdge{
+				F:     prev,
+				T:     n,
+				W:     distance(prevPoint, d.Route[j].Source),
+				Color: color,
+			})
+			prev = n
+			prevPoint = d.Route[j].Destination
+		}
+		g.SetWeightedEdge(dotEdge{
+			F:     prev,
+			T:     depot,
+			W:     distance(prevPoint, Depot),
+			Color: color,
+		})
+	}
+
+	b, err := dot.Marshal(g, "vrp", "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// deliveryNode builds the graph node for a Delivery, offsetting its ID so
+// it never collides with depotNodeID.
+func deliveryNode(d *Delivery) dotNode {
+	return dotNode{
+		id:    int64(d.ID) + 1,
+		label: fmt.Sprintf("%d (%.1f,%.1f)->(%.1f,%.1f)", d.ID, d.Source.X, d.Source.Y, d.Destination.X, d.Destination.Y),
+	}
+}
+
+// dotNode adapts a Delivery, or the depot, to gonum's graph.Node and
+// encoding/dot's Node interfaces.
+type dotNode struct {
+	id    int64
+	label string
+}
+
+func (n dotNode) ID() int64     { return n.id }
+func (n dotNode) DOTID() string { return n.label }
+
+// dotEdge adapts a leg of a route to gonum's graph.WeightedEdge and
+// encoding.Attributer so Graphviz can color each driver's chain and label
+// the distance travelled on each leg.
+type dotEdge struct {
+	F, T  graph.Node
+	W     float64
+	Color string
+}
+
+func (e dotEdge) From() graph.Node         { return e.F }
+func (e dotEdge) To() graph.Node           { return e.T }
+func (e dotEdge) Weight() float64          { return e.W }
+func (e dotEdge) ReversedEdge() graph.Edge { return dotEdge{F: e.T, T: e.F, W: e.W, Color: e.Color} }
+
+func (e dotEdge) Attributes() []encoding.Attribute {
+	return []encoding.Attribute{
+		{Key: "label", Value: strconv.FormatFloat(e.W, 'f', 2, 64)},
+		{Key: "color", Value: e.Color},
+	}
+}
@@ -0,0 +1,46 @@
+package vrp
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Encoder renders a solved set of driver routes to an output format. This
+// lets the CLI support multiple output modes (plain text, DOT, and
+// eventually things like GeoJSON or SVG) behind a single --format flag.
+type Encoder interface {
+	Encode(w io.Writer) error
+}
+
+// NewEncoder returns the Encoder for the requested --format value.
+func NewEncoder(format string, drivers []*Driver) (Encoder, error) {
+	switch format {
+	case "", "text":
+		return TextEncoder{Drivers: drivers}, nil
+	case "dot":
+		return DotEncoder{Drivers: drivers}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// TextEncoder renders each driver's route as a bracketed, comma-separated
+// list of delivery IDs, one line per driver. This is the original output
+// format of the tool.
+type TextEncoder struct {
+	Drivers []*Driver
+}
+
+func (e TextEncoder) Encode(w io.Writer) error {
+	for _, d := range e.Drivers {
+		ids := make([]string, len(d.Route))
+		for i, r := range d.Route {
+			ids[i] = fmt.Sprintf("%d", r.ID)
+		}
+		if _, err := fmt.Fprintf(w, "[%s]\n", strings.Join(ids, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package vrp
+
+import "fmt"
+
+// Improve applies a post-processing local-search phase to the drivers
+// built by any Solver; a Clarke-Wright solution, for one, typically leaves
+// 5-10% slack versus a local optimum.
+// "none" leaves the solution untouched, "intra" runs 2-opt and Or-opt on
+// each driver's own route, and "full" additionally relocates deliveries
+// between drivers. Every accepted move is checked against profile and
+// variant via feasible(), the same constraints the Clarke-Wright merge
+// loop enforces, so --improve never undoes a --vrp-variant/--profile
+// constraint.
+func Improve(drivers []*Driver, level string, profile VehicleProfile, variant string) ([]*Driver, error) {
+	switch level {
+	case "", "none":
+		return drivers, nil
+	case "intra":
+		for _, d := range drivers {
+			localSearch(d, profile, variant)
+		}
+		return drivers, nil
+	case "full":
+		for _, d := range drivers {
+			localSearch(d, profile, variant)
+		}
+		drivers = relocate(drivers, profile, variant)
+		for _, d := range drivers {
+			localSearch(d, profile, variant)
+		}
+		return drivers, nil
+	default:
+		return nil, fmt.Errorf("unknown improvement level %q", level)
+	}
+}
+
+// localSearch improves a single driver's route to a 2-opt/Or-opt local
+// minimum. Relocating deliveries within the route never changes which
+// driver they're assigned to, but does replace the route's backing slice,
+// so Assigned is re-pinned to d once the route settles.
+func localSearch(d *Driver, profile VehicleProfile, variant string) {
+	for {
+		before := computeTime(d.Route)
+		d.Route = twoOpt(d.Route, profile, variant)
+		d.Route = orOpt(d.Route, profile, variant)
+		if computeTime(d.Route) >= before {
+			break
+		}
+	}
+
+	for _, del := range d.Route {
+		del.Assigned = d
+	}
+}
+
+// twoOpt repeatedly reverses sub-sequences route[i..j] whenever doing so
+// reduces the route's total time while staying feasible under profile and
+// variant, continuing until no further improving reversal is found.
+func twoOpt(route []*Delivery, profile VehicleProfile, variant string) []*Delivery {
+	improved := true
+	for improved {
+		improved = false
+		best := computeTime(route)
+
+		for i := 0; i < len(route)-1; i++ {
+			for j := i + 1; j < len(route); j++ {
+				reverseSegment(route, i, j)
+				cost := computeTime(route)
+
+				if cost < best && feasible(route, profile, variant) {
+					best = cost
+					improved = true
+				} else {
+					reverseSegment(route, i, j)
+				}
+			}
+		}
+	}
+
+	return route
+}
+
+// reverseSegment reverses route[i..j] in place.
+func reverseSegment(route []*Delivery, i, j int) {
+	for i < j {
+		route[i], route[j] = route[j], route[i]
+		i++
+		j--
+	}
+}
+
+// orOpt relocates chains of 1, 2, or 3 consecutive deliveries to every
+// other position in the route, accepting a relocation whenever it reduces
+// total route time while staying feasible under profile and variant,
+// until no further improving relocation is found.
+func orOpt(route []*Delivery, profile VehicleProfile, variant string) []*Delivery {
+	improved := true
+	for improved {
+		improved = false
+
+		for l := 1; l <= 3 && l < len(route); l++ {
+			for i := 0; i+l <= len(route); i++ {
+				chain := append([]*Delivery(nil), route[i:i+l]...)
+				rest := append(append([]*Delivery(nil), route[:i]...), route[i+l:]...)
+
+				best := computeTime(route)
+				bestRoute := route
+				found := false
+
+				for j := 0; j <= len(rest); j++ {
+					candidate := make([]*Delivery, 0, len(route))
+					candidate = append(candidate, rest[:j]...)
+					candidate = append(candidate, chain...)
+					candidate = append(candidate, rest[j:]...)
+
+					cost := computeTime(candidate)
+					if cost < best && feasible(candidate, profile, variant) {
+						best = cost
+						bestRoute = candidate
+						found = true
+					}
+				}
+
+				if found {
+					route = bestRoute
+					improved = true
+				}
+			}
+		}
+	}
+
+	return route
+}
+
+// relocate moves a single delivery out of one driver's route and into
+// another's whenever doing so reduces the combined time of both routes
+// and both stay feasible under profile and variant afterward, repeating
+// until no further improving move is found. A driver left with an empty
+// route is removed via removeDriver.
+func relocate(drivers []*Driver, profile VehicleProfile, variant string) []*Driver {
+	improved := true
+	for improved {
+		improved = false
+
+		for _, from := range drivers {
+			if relocateOne(drivers, from, profile, variant) {
+				improved = true
+				if len(from.Route) == 0 {
+					drivers = removeDriver(drivers, *from)
+				}
+				break
+			}
+		}
+	}
+
+	return drivers
+}
+
+// relocateOne tries to move one delivery out of from's route into some
+// other driver's route, applying the first improving move it finds.
+func relocateOne(drivers []*Driver, from *Driver, profile VehicleProfile, variant string) bool {
+	for i, del := range from.Route {
+		fromWithout := append(append([]*Delivery(nil), from.Route[:i]...), from.Route[i+1:]...)
+		if !feasible(fromWithout, profile, variant) {
+			continue
+		}
+
+		for _, to := range drivers {
+			if to.ID == from.ID {
+				continue
+			}
+
+			before := computeTime(from.Route) + computeTime(to.Route)
+
+			for j := 0; j <= len(to.Route); j++ {
+				toWith := make([]*Delivery, 0, len(to.Route)+1)
+				toWith = append(toWith, to.Route[:j]...)
+				toWith = append(toWith, del)
+				toWith = append(toWith, to.Route[j:]...)
+
+				after := computeTime(fromWithout) + computeTime(toWith)
+				if after < before && feasible(toWith, profile, variant) {
+					from.Route = fromWithout
+					to.Route = toWith
+					del.Assigned = to
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
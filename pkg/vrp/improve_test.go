@@ -0,0 +1,52 @@
+package vrp
+
+import "testing"
+
+// TestLocalSearchAndRelocateKeepAssignedConsistent exercises the
+// invariant called out in the local-search request: after every accepted
+// 2-opt/Or-opt/relocate move, each Delivery's Assigned back-pointer must
+// match the Driver whose Route actually contains it.
+func TestLocalSearchAndRelocateKeepAssignedConsistent(t *testing.T) {
+	d1 := &Delivery{ID: 1, Source: Point{X: 0, Y: 0}, Destination: Point{X: 0, Y: 1}}
+	d2 := &Delivery{ID: 2, Source: Point{X: 9, Y: 0}, Destination: Point{X: 9, Y: 1}}
+	d3 := &Delivery{ID: 3, Source: Point{X: 10, Y: 0}, Destination: Point{X: 10, Y: 1}}
+	for _, d := range []*Delivery{d1, d2, d3} {
+		d.Time = distance(d.Source, d.Destination)
+	}
+
+	// d2 starts on driver a's route even though it sits right next to
+	// driver b's single delivery, so relocate has an obvious improving
+	// move available.
+	a := &Driver{ID: "a", Route: []*Delivery{d1, d2}}
+	b := &Driver{ID: "b", Route: []*Delivery{d3}}
+	d1.Assigned, d2.Assigned = a, a
+	d3.Assigned = b
+
+	drivers := []*Driver{a, b}
+
+	for _, d := range drivers {
+		localSearch(d, VehicleProfile{}, "")
+	}
+	assertAssignedConsistent(t, drivers)
+
+	drivers = relocate(drivers, VehicleProfile{}, "")
+	assertAssignedConsistent(t, drivers)
+
+	if d2.Assigned == a {
+		t.Fatalf("expected relocate to move delivery 2 off driver a, but it is still assigned to it")
+	}
+}
+
+// assertAssignedConsistent fails t if any driver's Route contains a
+// Delivery whose Assigned field doesn't point back to that driver.
+func assertAssignedConsistent(t *testing.T, drivers []*Driver) {
+	t.Helper()
+
+	for _, d := range drivers {
+		for _, del := range d.Route {
+			if del.Assigned != d {
+				t.Fatalf("delivery %d is in driver %s's route but Assigned points to driver %v", del.ID, d.ID, del.Assigned)
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package vrp
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// NearestNeighborSolver builds routes one driver at a time, repeatedly
+// appending whichever remaining delivery's pickup is closest to the
+// vehicle's current location, until adding that nearest delivery would
+// violate the Problem's VehicleProfile or variant constraints; farther
+// deliveries are not tried before the next driver is started.
+// It's a simple baseline to compare the other heuristics against.
+type NearestNeighborSolver struct{}
+
+func (NearestNeighborSolver) Solve(p Problem) (Solution, error) {
+	remaining := make(map[int]*Delivery, len(p.Deliveries))
+	for id, d := range p.Deliveries {
+		remaining[id] = d
+	}
+
+	drivers := make([]*Driver, 0)
+
+	for len(remaining) > 0 {
+		driver := &Driver{ID: uuid.Must(uuid.NewV7()).String(), Route: make([]*Delivery, 0)}
+		last := Depot
+
+		for {
+			nearest := nearestRemaining(last, remaining)
+			if nearest == nil {
+				break
+			}
+
+			candidate := append(append([]*Delivery(nil), driver.Route...), nearest)
+			if !feasible(candidate, p.Profile, p.Variant) {
+				break
+			}
+
+			driver.Route = candidate
+			nearest.Assigned = driver
+			last = nearest.Destination
+			delete(remaining, nearest.ID)
+		}
+
+		// A single delivery that doesn't fit alone would otherwise loop
+		// forever with an empty driver; place it by itself instead.
+		if len(driver.Route) == 0 {
+			d := nearestRemaining(Depot, remaining)
+			driver.Route = append(driver.Route, d)
+			d.Assigned = driver
+			delete(remaining, d.ID)
+		}
+
+		drivers = append(drivers, driver)
+	}
+
+	return Solution{Drivers: drivers}, nil
+}
+
+// nearestRemaining returns whichever delivery in remaining has the
+// closest pickup to from, or nil if remaining is empty.
+func nearestRemaining(from Point, remaining map[int]*Delivery) *Delivery {
+	var nearest *Delivery
+	nearestDist := math.Inf(1)
+
+	for _, d := range remaining {
+		dist := distance(from, d.Source)
+		if dist < nearestDist {
+			nearest = d
+			nearestDist = dist
+		}
+	}
+
+	return nearest
+}
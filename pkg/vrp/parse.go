@@ -0,0 +1,148 @@
+package vrp
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Parse reads an input file and returns a map of Deliveries indexed by ID.
+// The file is a space-delimited CSV with a header row; the first 3
+// columns (id, source, destination) are required, and an optional 4th
+// demand column, 5th "[earliest,latest]" time window column, and 6th
+// service-time column may follow, in that order, so existing input files
+// keep working unchanged.
+func Parse(fName string) (map[int]*Delivery, error) {
+	f, err := os.Open(fName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// File is space delimited. Treat as a CSV.
+	reader := csv.NewReader(f)
+	reader.Comma = ' '
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) < 2 {
+		return nil, errors.New("improperly formatted input file")
+	}
+
+	ret := make(map[int]*Delivery, 0)
+
+	// Be sure to skip the first line
+	for i := 1; i < len(records); i++ {
+		if len(records[i]) < 3 || len(records[i]) > 6 {
+			return nil, errors.New("improperly formatted input file")
+		}
+		id := records[i][0]
+		source := records[i][1]
+		dest := records[i][2]
+
+		idInt, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, errors.New("improperly formatted input file")
+		}
+
+		// Parse source and destination coordinates and remove the parentheses
+		sourceCoord := strings.Split(strings.ReplaceAll(strings.ReplaceAll(source, "(", ""), ")", ""), ",")
+		if len(sourceCoord) != 2 {
+			return nil, errors.New("improperly formatted input file")
+		}
+		destCoord := strings.Split(strings.ReplaceAll(strings.ReplaceAll(dest, "(", ""), ")", ""), ",")
+		if len(destCoord) != 2 {
+			return nil, errors.New("improperly formatted input file")
+		}
+
+		// Convert coordinates into floating points (using float64 cuz no real reason not to)
+		sourceX, err := strconv.ParseFloat(sourceCoord[0], 64)
+		if err != nil {
+			return nil, errors.New("improperly formatted input file")
+		}
+		sourceY, err := strconv.ParseFloat(sourceCoord[1], 64)
+		if err != nil {
+			return nil, errors.New("improperly formatted input file")
+		}
+
+		destX, err := strconv.ParseFloat(destCoord[0], 64)
+		if err != nil {
+			return nil, errors.New("improperly formatted input file")
+		}
+		destY, err := strconv.ParseFloat(destCoord[1], 64)
+		if err != nil {
+			return nil, errors.New("improperly formatted input file")
+		}
+
+		d := Delivery{
+			ID: idInt,
+			Source: Point{
+				X: sourceX,
+				Y: sourceY,
+			},
+			Destination: Point{
+				X: destX,
+				Y: destY,
+			},
+		}
+
+		// Precompute the drive time
+		d.Time = distance(d.Source, d.Destination)
+
+		if len(records[i]) >= 4 {
+			demand, err := strconv.ParseFloat(records[i][3], 64)
+			if err != nil {
+				return nil, errors.New("improperly formatted input file")
+			}
+			d.Demand = demand
+		}
+
+		if len(records[i]) >= 5 {
+			ready, due, err := parseWindow(records[i][4])
+			if err != nil {
+				return nil, err
+			}
+			d.ReadyTime = ready
+			d.DueTime = due
+		}
+
+		if len(records[i]) == 6 {
+			service, err := strconv.ParseFloat(records[i][5], 64)
+			if err != nil {
+				return nil, errors.New("improperly formatted input file")
+			}
+			d.ServiceTime = service
+		}
+
+		ret[idInt] = &d
+	}
+
+	return ret, nil
+}
+
+// parseWindow parses a time window of the form "[earliest,latest]".
+func parseWindow(s string) (earliest, latest float64, err error) {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("improperly formatted input file")
+	}
+
+	earliest, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, 0, errors.New("improperly formatted input file")
+	}
+
+	latest, err = strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, 0, errors.New("improperly formatted input file")
+	}
+
+	return earliest, latest, nil
+}
@@ -0,0 +1,105 @@
+package vrp
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// VehicleProfile describes the capacity and shift-length limits shared by
+// every driver in a solve. It is loaded from a JSON file via --profile;
+// the zero value disables the capacity constraint and falls back to
+// MaxTime for the shift length.
+type VehicleProfile struct {
+	Capacity        float64 `json:"capacity,omitempty"`
+	MaxShiftMinutes float64 `json:"max_shift_minutes,omitempty"`
+	FixedCost       float64 `json:"fixed_cost,omitempty"`
+}
+
+// LoadProfile reads a VehicleProfile from a JSON file.
+func LoadProfile(fName string) (VehicleProfile, error) {
+	b, err := os.ReadFile(fName)
+	if err != nil {
+		return VehicleProfile{}, err
+	}
+
+	var p VehicleProfile
+	if err := json.Unmarshal(b, &p); err != nil {
+		return VehicleProfile{}, err
+	}
+
+	return p, nil
+}
+
+// maxShift returns the shift-length limit to enforce for profile, falling
+// back to MaxTime when the profile doesn't specify one.
+func maxShift(profile VehicleProfile) float64 {
+	if profile.MaxShiftMinutes > 0 {
+		return profile.MaxShiftMinutes
+	}
+	return MaxTime
+}
+
+// feasible reports whether nodes can form a single route under profile
+// and the given --vrp-variant. Every route must fit within the profile's
+// shift length; cvrp and cvrptw additionally enforce capacity, and vrptw
+// and cvrptw additionally enforce delivery time windows.
+func feasible(nodes []*Delivery, profile VehicleProfile, variant string) bool {
+	if len(nodes) == 0 {
+		return true
+	}
+
+	if computeTime(nodes) > maxShift(profile) {
+		return false
+	}
+
+	switch variant {
+	case "cvrp":
+		return feasibleCapacity(nodes, profile)
+	case "vrptw":
+		return feasibleTimeWindow(nodes)
+	case "cvrptw":
+		return feasibleCapacity(nodes, profile) && feasibleTimeWindow(nodes)
+	default:
+		return true
+	}
+}
+
+// feasibleCapacity reports whether the cumulative demand along nodes
+// stays within profile.Capacity. A zero Capacity means the constraint is
+// disabled.
+func feasibleCapacity(nodes []*Delivery, profile VehicleProfile) bool {
+	if profile.Capacity <= 0 {
+		return true
+	}
+
+	total := 0.0
+	for _, n := range nodes {
+		total += n.Demand
+	}
+
+	return total <= profile.Capacity
+}
+
+// feasibleTimeWindow forward-propagates arrival times along nodes, the
+// same way computeTime accumulates drive time, and reports whether every
+// stop's time window is satisfied: arriving before ReadyTime means the
+// vehicle waits, and arriving after a non-zero DueTime is infeasible.
+func feasibleTimeWindow(nodes []*Delivery) bool {
+	t := distance(Depot, nodes[0].Source)
+
+	for i, n := range nodes {
+		if t < n.ReadyTime {
+			t = n.ReadyTime
+		}
+		if n.DueTime > 0 && t > n.DueTime {
+			return false
+		}
+
+		t += n.ServiceTime + n.Time
+		if i != len(nodes)-1 {
+			t += distance(n.Destination, nodes[i+1].Source)
+		}
+	}
+
+	return true
+}
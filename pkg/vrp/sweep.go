@@ -0,0 +1,52 @@
+package vrp
+
+import (
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// SweepSolver builds routes by sorting deliveries by polar angle around
+// the depot and greedily packing them, in that order, onto a driver
+// until adding the next one would violate the Problem's VehicleProfile
+// or variant constraints, at which point a new driver is started.
+type SweepSolver struct{}
+
+func (SweepSolver) Solve(p Problem) (Solution, error) {
+	deliveries := make([]*Delivery, 0, len(p.Deliveries))
+	for _, d := range p.Deliveries {
+		deliveries = append(deliveries, d)
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return polarAngle(deliveries[i].Source) < polarAngle(deliveries[j].Source)
+	})
+
+	drivers := make([]*Driver, 0)
+	driver := &Driver{ID: uuid.Must(uuid.NewV7()).String(), Route: make([]*Delivery, 0)}
+
+	for _, d := range deliveries {
+		candidate := append(append([]*Delivery(nil), driver.Route...), d)
+
+		if len(driver.Route) > 0 && !feasible(candidate, p.Profile, p.Variant) {
+			drivers = append(drivers, driver)
+			driver = &Driver{ID: uuid.Must(uuid.NewV7()).String(), Route: make([]*Delivery, 0)}
+			candidate = []*Delivery{d}
+		}
+
+		driver.Route = candidate
+		d.Assigned = driver
+	}
+
+	if len(driver.Route) > 0 {
+		drivers = append(drivers, driver)
+	}
+
+	return Solution{Drivers: drivers}, nil
+}
+
+// polarAngle returns the angle of p around Depot, in radians.
+func polarAngle(p Point) float64 {
+	return math.Atan2(p.Y-Depot.Y, p.X-Depot.X)
+}
@@ -0,0 +1,138 @@
+// Package vrp implements heuristic solvers for the vehicle routing
+// problem (VRP) and its capacitated (CVRP) and time-windowed (VRPTW)
+// variants, along with encoders for rendering a solution.
+package vrp
+
+import "math"
+
+// Driver is a single vehicle and the ordered sequence of deliveries
+// assigned to it.
+type Driver struct {
+	ID    string      `json:"id"`
+	Route []*Delivery `json:"-"`
+}
+
+// Point is a location in Cartesian space.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Delivery is a single pickup-and-dropoff job.
+type Delivery struct {
+	ID          int     `json:"id"`
+	Source      Point   `json:"point"`
+	Destination Point   `json:"destination"`
+	Time        float64 `json:"time"`
+	Assigned    *Driver `json:"driver"`
+
+	// Demand is the amount of capacity this delivery consumes, checked
+	// against VehicleProfile.Capacity under the cvrp/cvrptw variants.
+	Demand float64 `json:"demand,omitempty"`
+
+	// ReadyTime and DueTime describe the [earliest,latest] window during
+	// which the delivery may be served, checked under the vrptw/cvrptw
+	// variants. A zero DueTime means the window is unconstrained.
+	ReadyTime float64 `json:"ready_time,omitempty"`
+	DueTime   float64 `json:"due_time,omitempty"`
+
+	// ServiceTime is added to the time spent at this stop before the
+	// vehicle can depart for the next one.
+	ServiceTime float64 `json:"service_time,omitempty"`
+}
+
+// Savings is a single Clarke-Wright merge candidate.
+type Savings struct {
+	SourceID      int     `json:"source_id"`
+	DestinationID int     `json:"destination_id"`
+	Amount        float64 `json:"savings"`
+}
+
+// BySaving sorts a slice of Savings.
+type BySaving []Savings
+
+func (s BySaving) Len() int           { return len(s) }
+func (a BySaving) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a BySaving) Less(i, j int) bool { return a[i].Amount < a[j].Amount }
+
+// Depot is the single start/end location shared by every driver.
+var Depot = Point{X: 0.0, Y: 0.0}
+
+// MaxTime is the default per-driver shift length, used when a Problem's
+// VehicleProfile doesn't specify MaxShiftMinutes.
+const MaxTime = 12.0 * 60.0
+
+// Problem is a fully-specified VRP instance: the deliveries to route, the
+// vehicle profile shared by every driver, and which constraint variant
+// (cvrp/vrptw/cvrptw) to enforce.
+type Problem struct {
+	Deliveries map[int]*Delivery
+	Profile    VehicleProfile
+	Variant    string
+}
+
+// Solution is the result of running a Solver over a Problem.
+type Solution struct {
+	Drivers []*Driver
+}
+
+// Solver builds a Solution for a Problem using some construction
+// heuristic.
+type Solver interface {
+	Solve(Problem) (Solution, error)
+}
+
+// removeDriver removes a driver from a slice of drivers by ID.
+func removeDriver(drivers []*Driver, d Driver) []*Driver {
+	idx := -1
+
+	for i, dr := range drivers {
+		if dr.ID == d.ID {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		return nil
+	}
+
+	return append(drivers[:idx], drivers[idx+1:]...)
+}
+
+// indexOf finds the index of a delivery in a slice based on ID.
+func indexOf(d *Delivery, arr []*Delivery) int {
+	for i, n := range arr {
+		if n.ID == d.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+// computeTime computes the total time of a route, including the
+// depot->first-pickup and last-dropoff->depot legs.
+func computeTime(nodes []*Delivery) float64 {
+	if len(nodes) == 0 {
+		return 0.0
+	}
+
+	time := 0.0
+	for i := 0; i < len(nodes); i++ {
+		time += nodes[i].Time
+		if i != (len(nodes) - 1) {
+			time += distance(nodes[i].Destination, nodes[i+1].Source)
+		}
+	}
+
+	time += distance(Depot, nodes[0].Source)
+	time += distance(nodes[len(nodes)-1].Destination, Depot)
+
+	return time
+}
+
+// distance is the Euclidean distance between two points in Cartesian
+// space.
+func distance(i, j Point) float64 {
+	return math.Sqrt((i.X-j.X)*(i.X-j.X) + (i.Y-j.Y)*(i.Y-j.Y))
+}